@@ -0,0 +1,38 @@
+package page
+
+import "fmt"
+
+type IndexedOption[T any] func(*IndexedPager[T]) error
+
+func WithIndexedLoader[T any](loader IndexedLoader[T]) IndexedOption[T] {
+	return func(p *IndexedPager[T]) error {
+		if loader == nil {
+			return fmt.Errorf("indexed loader is required")
+		}
+		p.loader = loader
+		return nil
+	}
+}
+
+func WithIndexedPageSize[T any](pageSize int) IndexedOption[T] {
+	return func(p *IndexedPager[T]) error {
+		if pageSize <= 0 {
+			return fmt.Errorf("page size must be positive")
+		}
+		p.pageSize = pageSize
+		return nil
+	}
+}
+
+// WithStartPage sets the current page number, as reported by PageNumber and
+// used by Prev and Next, before any page has been loaded. Defaults to 1.
+// Page, First and Last do not depend on it.
+func WithStartPage[T any](pageNumber int) IndexedOption[T] {
+	return func(p *IndexedPager[T]) error {
+		if pageNumber < 1 {
+			return fmt.Errorf("start page must be positive")
+		}
+		p.currentPage = pageNumber
+		return nil
+	}
+}
@@ -0,0 +1,180 @@
+package page
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+)
+
+const defaultStartPage = 1
+
+type (
+	IndexedLoader[T any] interface {
+		// Load loads the elements of the given 1-based page number and
+		// reports the total number of elements across all pages.
+		Load(ctx context.Context, pageNumber int, pageSize int) (page []T, totalElements int, err error)
+	}
+
+	// IndexedPager supports random-access, numbered-page pagination (e.g. for
+	// UI-facing "page 1 2 3 ... next" navigation), as opposed to Pager's
+	// cursor-only model. Already-fetched pages are cached by page number so
+	// that Prev/First/Page(i) don't re-hit the IndexedLoader.
+	IndexedPager[T any] struct {
+		// mu guards all mutable fields below.
+		mu sync.Mutex
+		// elements count per page.
+		pageSize int
+		// loader that loads a page by number.
+		loader IndexedLoader[T]
+		// number of the page last returned by Page/First/Last/Prev/Next.
+		currentPage int
+		// total elements, as reported by the loader on the last loaded page.
+		totalElements int
+		// this field is used to check if totalElements has a value from the
+		// loader yet, since 0 is also a valid element count.
+		totalElementsLoaded bool
+		// cache of already-loaded pages, keyed by page number.
+		pages map[int][]T
+	}
+)
+
+// NewIndexed creates a new IndexedPager.
+func NewIndexed[T any](opts ...IndexedOption[T]) (*IndexedPager[T], error) {
+	pager := &IndexedPager[T]{
+		pageSize:    defaultPageSize,
+		currentPage: defaultStartPage,
+		pages:       make(map[int][]T),
+	}
+
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+
+	if pager.loader == nil {
+		return nil, fmt.Errorf("indexed loader is required")
+	}
+
+	return pager, nil
+}
+
+// Page returns the elements of the i-th page (1-based), loading it via the
+// IndexedLoader on first access and serving it from cache afterwards. The
+// lock is not held across the (potentially slow) Load call, only around
+// reading and updating the Pager's own state, so it doesn't block
+// PageNumber, TotalElements or a concurrent Page/Prev/Next call while a page
+// is in flight.
+func (p *IndexedPager[T]) Page(ctx context.Context, i int) ([]T, error) {
+	if i < 1 {
+		return nil, fmt.Errorf("page number must be positive")
+	}
+
+	p.mu.Lock()
+	if page, ok := p.pages[i]; ok {
+		p.currentPage = i
+		p.mu.Unlock()
+		return page, nil
+	}
+	pageSize := p.pageSize
+	p.mu.Unlock()
+
+	page, totalElements, err := p.loader.Load(ctx, i, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("page %d: %w", i, err)
+	}
+
+	p.mu.Lock()
+	p.pages[i] = page
+	p.totalElements = totalElements
+	p.totalElementsLoaded = true
+	p.currentPage = i
+	p.mu.Unlock()
+
+	return page, nil
+}
+
+// First returns the first page of elements.
+func (p *IndexedPager[T]) First(ctx context.Context) ([]T, error) {
+	return p.Page(ctx, defaultStartPage)
+}
+
+// Last returns the last page of elements, loading a page first if the total
+// element count is not yet known. For an empty result set (TotalElements
+// == 0), the last page is the same, empty, first page.
+func (p *IndexedPager[T]) Last(ctx context.Context) ([]T, error) {
+	if err := p.ensureTotalElementsLoaded(ctx); err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	lastPage := p.totalPagesLocked()
+	p.mu.Unlock()
+
+	return p.Page(ctx, lastPage)
+}
+
+// Prev returns the page before the current one.
+func (p *IndexedPager[T]) Prev(ctx context.Context) ([]T, error) {
+	p.mu.Lock()
+	prev := p.currentPage - 1
+	p.mu.Unlock()
+	return p.Page(ctx, prev)
+}
+
+// Next returns the page after the current one.
+func (p *IndexedPager[T]) Next(ctx context.Context) ([]T, error) {
+	p.mu.Lock()
+	next := p.currentPage + 1
+	p.mu.Unlock()
+	return p.Page(ctx, next)
+}
+
+// PageNumber returns the number of the page last returned by Page, First,
+// Last, Prev or Next.
+func (p *IndexedPager[T]) PageNumber() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.currentPage
+}
+
+// TotalElements returns the total number of elements, as reported by the
+// IndexedLoader. It is zero until a page has been loaded.
+func (p *IndexedPager[T]) TotalElements() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.totalElements
+}
+
+// TotalPages returns the total number of pages, derived from TotalElements
+// and the configured page size. It is zero until a page has been loaded.
+func (p *IndexedPager[T]) TotalPages() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.totalPagesLocked()
+}
+
+func (p *IndexedPager[T]) ensureTotalElementsLoaded(ctx context.Context) error {
+	p.mu.Lock()
+	loaded := p.totalElementsLoaded
+	p.mu.Unlock()
+	if loaded {
+		return nil
+	}
+	_, err := p.First(ctx)
+	return err
+}
+
+// totalPagesLocked is TotalPages without locking. Must be called with p.mu
+// held. An empty result set (totalElements == 0) still has one, empty, page,
+// the same way a Pager with no elements still has a first page.
+func (p *IndexedPager[T]) totalPagesLocked() int {
+	if !p.totalElementsLoaded {
+		return 0
+	}
+	if p.totalElements == 0 {
+		return 1
+	}
+	return int(math.Ceil(float64(p.totalElements) / float64(p.pageSize)))
+}
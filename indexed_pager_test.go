@@ -0,0 +1,188 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIndexed(t *testing.T) {
+	cases := []struct {
+		name    string
+		opts    []IndexedOption[int]
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "ok, with options",
+			opts: []IndexedOption[int]{
+				WithIndexedLoader[int](&fakeIndexedLoader{}),
+				WithIndexedPageSize[int](20),
+				WithStartPage[int](2),
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:    "no loader",
+			wantErr: func(t require.TestingT, err error, _ ...interface{}) { require.EqualError(t, err, "indexed loader is required") },
+		},
+		{
+			name: "zero page size",
+			opts: []IndexedOption[int]{
+				WithIndexedLoader[int](&fakeIndexedLoader{}),
+				WithIndexedPageSize[int](0),
+			},
+			wantErr: func(t require.TestingT, err error, _ ...interface{}) { require.EqualError(t, err, "page size must be positive") },
+		},
+		{
+			name: "zero start page",
+			opts: []IndexedOption[int]{
+				WithIndexedLoader[int](&fakeIndexedLoader{}),
+				WithStartPage[int](0),
+			},
+			wantErr: func(t require.TestingT, err error, _ ...interface{}) { require.EqualError(t, err, "start page must be positive") },
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewIndexed(tc.opts...)
+			tc.wantErr(t, err)
+		})
+	}
+}
+
+func TestIndexedPager_Navigation(t *testing.T) {
+	loader := &fakeIndexedLoader{
+		pageByNumber: map[int][]int{
+			1: {1, 2, 3},
+			2: {4, 5, 6},
+			3: {7, 8},
+		},
+		totalElements: 8,
+	}
+
+	pager, err := NewIndexed[int](WithIndexedLoader[int](loader), WithIndexedPageSize[int](3))
+	require.NoError(t, err)
+
+	got, err := pager.First(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3}, got)
+	require.Equal(t, 1, pager.PageNumber())
+
+	got, err = pager.Next(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []int{4, 5, 6}, got)
+	require.Equal(t, 2, pager.PageNumber())
+
+	got, err = pager.Last(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []int{7, 8}, got)
+	require.Equal(t, 3, pager.PageNumber())
+	require.Equal(t, 3, pager.TotalPages())
+	require.Equal(t, 8, pager.TotalElements())
+
+	got, err = pager.Prev(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []int{4, 5, 6}, got)
+	require.Equal(t, 1, loader.loadCalls[2])
+
+	got, err = pager.Page(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3}, got)
+	require.Equal(t, 1, loader.loadCalls[1])
+}
+
+func TestIndexedPager_Page_Error(t *testing.T) {
+	loader := &fakeIndexedLoader{errOnPageNumber: 1}
+
+	pager, err := NewIndexed[int](WithIndexedLoader[int](loader))
+	require.NoError(t, err)
+
+	_, err = pager.Page(context.Background(), 1)
+	require.EqualError(t, err, "page 1: test error")
+
+	_, err = pager.Page(context.Background(), 0)
+	require.EqualError(t, err, "page number must be positive")
+}
+
+func TestIndexedPager_Last_EmptyDataset(t *testing.T) {
+	loader := &fakeIndexedLoader{pageByNumber: map[int][]int{}, totalElements: 0}
+
+	pager, err := NewIndexed[int](WithIndexedLoader[int](loader))
+	require.NoError(t, err)
+
+	got, err := pager.Last(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, got)
+	require.Equal(t, 1, pager.PageNumber())
+	require.Equal(t, 1, pager.TotalPages())
+	require.Equal(t, 0, pager.TotalElements())
+}
+
+func TestIndexedPager_Page_DoesNotBlockOnConcurrentInFlightLoad(t *testing.T) {
+	blockLoad := make(chan struct{})
+	loader := &blockingIndexedLoader{unblock: blockLoad}
+
+	pager, err := NewIndexed[int](WithIndexedLoader[int](loader))
+	require.NoError(t, err)
+
+	pageDone := make(chan struct{})
+	go func() {
+		defer close(pageDone)
+		_, _ = pager.Page(context.Background(), 1)
+	}()
+
+	// Give the goroutine above a moment to enter the (blocked) Load call.
+	time.Sleep(10 * time.Millisecond)
+
+	numberDone := make(chan struct{})
+	go func() {
+		defer close(numberDone)
+		pager.PageNumber()
+		pager.TotalElements()
+	}()
+
+	select {
+	case <-numberDone:
+	case <-time.After(200 * time.Millisecond):
+		t.Fatal("PageNumber/TotalElements blocked on an in-flight Page call")
+	}
+
+	close(blockLoad)
+	<-pageDone
+}
+
+type blockingIndexedLoader struct {
+	unblock <-chan struct{}
+}
+
+func (l *blockingIndexedLoader) Load(ctx context.Context, _ int, _ int) ([]int, int, error) {
+	select {
+	case <-l.unblock:
+		return []int{1}, 1, nil
+	case <-ctx.Done():
+		return nil, 0, ctx.Err()
+	}
+}
+
+type fakeIndexedLoader struct {
+	pageByNumber    map[int][]int
+	totalElements   int
+	errOnPageNumber int
+	loadCalls       map[int]int
+}
+
+func (l *fakeIndexedLoader) Load(_ context.Context, pageNumber int, _ int) ([]int, int, error) {
+	if l.loadCalls == nil {
+		l.loadCalls = make(map[int]int)
+	}
+	l.loadCalls[pageNumber]++
+
+	if l.errOnPageNumber == pageNumber {
+		return nil, 0, errors.New("test error")
+	}
+	return l.pageByNumber[pageNumber], l.totalElements, nil
+}
@@ -0,0 +1,79 @@
+package page
+
+import (
+	"context"
+	"iter"
+)
+
+// Iter returns a Go 1.23 push iterator that yields elements one-by-one,
+// flattening pages internally, so callers can do:
+//
+//	for el, err := range pager.Iter(ctx) {
+//		if err != nil {
+//			// handle and break
+//		}
+//		...
+//	}
+//
+// A Loader error is yielded once (with the zero value of T) and ends
+// iteration. If ctx is done, ctx.Err() is yielded the same way. Iteration
+// also stops cleanly when the caller breaks out of the range loop.
+func (p *Pager[T]) Iter(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for !p.IsAllLoaded() {
+			select {
+			case <-ctx.Done():
+				var zero T
+				yield(zero, ctx.Err())
+				return
+			default:
+			}
+
+			page, err := p.Next(ctx)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, el := range page {
+				if !yield(el, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Stream is a channel-based equivalent of Iter for callers that prefer
+// select-based consumption over range-over-func. It starts a background
+// goroutine that feeds elCh with elements from each loaded page and sends at
+// most one error to errCh before closing both channels. The goroutine exits
+// once all pages are loaded, a Loader error occurs, or ctx is done.
+func (p *Pager[T]) Stream(ctx context.Context) (elCh <-chan T, errCh <-chan error) {
+	elements := make(chan T)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(elements)
+		defer close(errs)
+
+		for el, err := range p.Iter(ctx) {
+			if err != nil {
+				select {
+				case errs <- err:
+				case <-ctx.Done():
+				}
+				return
+			}
+
+			select {
+			case elements <- el:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return elements, errs
+}
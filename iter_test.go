@@ -0,0 +1,110 @@
+package page
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPager_Iter(t *testing.T) {
+	loader := &fakeLoader{
+		pageByKey: map[string][]int{
+			"":                {1, 2, 3},
+			"second-page-key": {4, 5, 6},
+			"third-page-key":  {7, 8},
+		},
+		nextPageKeyByPageKey: map[string]string{
+			"":                "second-page-key",
+			"second-page-key": "third-page-key",
+			"third-page-key":  "",
+		},
+		errOnPageKey: "no-error",
+	}
+
+	pager, err := New[int](WithNextPageLoader[int](loader), WithPageSize[int](3))
+	require.NoError(t, err)
+
+	var got []int
+	for el, err := range pager.Iter(context.Background()) {
+		require.NoError(t, err)
+		got = append(got, el)
+	}
+	require.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, got)
+}
+
+func TestPager_Iter_StopsOnBreak(t *testing.T) {
+	loader := &fakeLoader{
+		pageByKey: map[string][]int{
+			"":                {1, 2, 3},
+			"second-page-key": {4, 5, 6},
+		},
+		nextPageKeyByPageKey: map[string]string{
+			"":                "second-page-key",
+			"second-page-key": "",
+		},
+		errOnPageKey: "no-error",
+	}
+
+	pager, err := New[int](WithNextPageLoader[int](loader), WithPageSize[int](3))
+	require.NoError(t, err)
+
+	var got []int
+	for el, err := range pager.Iter(context.Background()) {
+		require.NoError(t, err)
+		got = append(got, el)
+		if el == 2 {
+			break
+		}
+	}
+	require.Equal(t, []int{1, 2}, got)
+}
+
+func TestPager_Iter_LoaderError(t *testing.T) {
+	loader := &fakeLoader{
+		pageByKey:            map[string][]int{"": {1, 2, 3}},
+		nextPageKeyByPageKey: map[string]string{"": "second-page-key"},
+		errOnPageKey:         "second-page-key",
+	}
+
+	pager, err := New[int](WithNextPageLoader[int](loader), WithPageSize[int](3))
+	require.NoError(t, err)
+
+	var got []int
+	var gotErr error
+	for el, err := range pager.Iter(context.Background()) {
+		if err != nil {
+			gotErr = err
+			break
+		}
+		got = append(got, el)
+	}
+	require.Equal(t, []int{1, 2, 3}, got)
+	require.EqualError(t, gotErr, "page second-page-key: test error")
+}
+
+func TestPager_Stream(t *testing.T) {
+	loader := &fakeLoader{
+		pageByKey: map[string][]int{
+			"":                {1, 2, 3},
+			"second-page-key": {4, 5, 6},
+		},
+		nextPageKeyByPageKey: map[string]string{
+			"":                "second-page-key",
+			"second-page-key": "",
+		},
+		errOnPageKey: "no-error",
+	}
+
+	pager, err := New[int](WithNextPageLoader[int](loader), WithPageSize[int](3))
+	require.NoError(t, err)
+
+	elCh, errCh := pager.Stream(context.Background())
+
+	var got []int
+	for el := range elCh {
+		got = append(got, el)
+	}
+	require.NoError(t, <-errCh)
+	require.Equal(t, []int{1, 2, 3, 4, 5, 6}, got)
+}
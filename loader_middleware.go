@@ -0,0 +1,223 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+type (
+	// RetryPolicy configures RetryLoader.
+	RetryPolicy struct {
+		// MaxAttempts is the total number of attempts, including the first
+		// one. Values <= 1 disable retrying.
+		MaxAttempts int
+		// IsRetryable classifies an error returned by the wrapped Loader as
+		// retryable. It is consulted in addition to the built-in detection
+		// of context.DeadlineExceeded. May be nil.
+		IsRetryable func(error) bool
+		// Logger, if set, is notified of every failed attempt, including
+		// ones that are ultimately retried.
+		Logger ErrorLogger
+	}
+
+	// BackoffStrategy computes the delay before a given retry attempt.
+	// attempt is 1 for the delay before the second overall attempt, 2 for
+	// the delay before the third, and so on.
+	BackoffStrategy interface {
+		Delay(attempt int) time.Duration
+	}
+
+	// ExponentialBackoff is a BackoffStrategy that doubles the delay on each
+	// attempt, up to Max, and returns a random duration in [delay/2, delay] so
+	// that concurrent retries don't all land on the same instant.
+	ExponentialBackoff struct {
+		Base time.Duration
+		Max  time.Duration
+	}
+
+	// ErrorLogger receives errors returned while loading a page.
+	ErrorLogger interface {
+		LogError(pageKey string, err error)
+	}
+
+	// DistinctErrorLogger wraps an ErrorLogger and suppresses consecutive,
+	// identical error messages for the same page key, so that a page that
+	// keeps failing the same way doesn't spam the logs on every retry.
+	DistinctErrorLogger struct {
+		next ErrorLogger
+
+		mu          sync.Mutex
+		lastErrMsgs map[string]string
+	}
+
+	retryingLoader[T any] struct {
+		next    Loader[T]
+		policy  RetryPolicy
+		backoff BackoffStrategy
+	}
+
+	rateLimitedLoader[T any] struct {
+		next     Loader[T]
+		interval time.Duration
+
+		mu       sync.Mutex
+		notAfter time.Time
+	}
+)
+
+// Delay implements BackoffStrategy.
+func (b ExponentialBackoff) Delay(attempt int) time.Duration {
+	delay := b.Base
+	for i := 0; i < attempt && delay > 0 && delay < b.Max; i++ {
+		delay *= 2
+	}
+	if delay <= 0 || delay > b.Max {
+		delay = b.Max
+	}
+	if delay <= 0 {
+		return 0
+	}
+
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// NewDistinctErrorLogger creates a DistinctErrorLogger delegating to next.
+func NewDistinctErrorLogger(next ErrorLogger) *DistinctErrorLogger {
+	return &DistinctErrorLogger{next: next, lastErrMsgs: make(map[string]string)}
+}
+
+// LogError implements ErrorLogger.
+func (l *DistinctErrorLogger) LogError(pageKey string, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	msg := err.Error()
+	if l.lastErrMsgs[pageKey] == msg {
+		return
+	}
+	l.lastErrMsgs[pageKey] = msg
+	l.next.LogError(pageKey, err)
+}
+
+// RetryLoader wraps loader so that a failed Load is retried according to
+// policy. Pass the result to BackoffLoader to add delay between attempts.
+//
+// Named RetryLoader rather than WithRetry: unlike the package's WithX
+// options (WithPageSize, WithPrefetch, ...), which configure a Pager and are
+// passed to New, this wraps a Loader directly and returns a Loader.
+func RetryLoader[T any](loader Loader[T], policy RetryPolicy) Loader[T] {
+	return &retryingLoader[T]{next: loader, policy: policy}
+}
+
+// BackoffLoader attaches strategy as the delay used between retry attempts
+// of a loader previously wrapped with RetryLoader. loader must be the result
+// of RetryLoader (not, say, a RateLimitLoader wrapping a RetryLoader, or a
+// bare Loader); BackoffLoader panics otherwise, since silently discarding
+// the strategy would leave retries running with no backoff at all.
+func BackoffLoader[T any](loader Loader[T], strategy BackoffStrategy) Loader[T] {
+	rl, ok := loader.(*retryingLoader[T])
+	if !ok {
+		panic("page: BackoffLoader must wrap the Loader returned by RetryLoader")
+	}
+	rl.backoff = strategy
+	return rl
+}
+
+// RateLimitLoader wraps loader so that Load is called at most rps times per
+// second. rps <= 0 disables rate limiting.
+func RateLimitLoader[T any](loader Loader[T], rps float64) Loader[T] {
+	if rps <= 0 {
+		return loader
+	}
+	return &rateLimitedLoader[T]{next: loader, interval: time.Duration(float64(time.Second) / rps)}
+}
+
+// Load implements Loader.
+func (l *retryingLoader[T]) Load(ctx context.Context, pageKey string, pageSize int) ([]T, string, error) {
+	maxAttempts := l.policy.MaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	var lastErr error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		page, nextPageKey, err := l.next.Load(ctx, pageKey, pageSize)
+		if err == nil {
+			return page, nextPageKey, nil
+		}
+		lastErr = err
+
+		if l.policy.Logger != nil {
+			l.policy.Logger.LogError(pageKey, err)
+		}
+
+		if attempt == maxAttempts || !l.isRetryable(err) {
+			return nil, "", err
+		}
+
+		if err := l.wait(ctx, attempt); err != nil {
+			return nil, "", err
+		}
+	}
+	return nil, "", lastErr
+}
+
+func (l *retryingLoader[T]) isRetryable(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	return l.policy.IsRetryable != nil && l.policy.IsRetryable(err)
+}
+
+func (l *retryingLoader[T]) wait(ctx context.Context, attempt int) error {
+	if l.backoff == nil {
+		return nil
+	}
+
+	timer := time.NewTimer(l.backoff.Delay(attempt))
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Load implements Loader.
+func (l *rateLimitedLoader[T]) Load(ctx context.Context, pageKey string, pageSize int) ([]T, string, error) {
+	if err := l.wait(ctx); err != nil {
+		return nil, "", err
+	}
+	return l.next.Load(ctx, pageKey, pageSize)
+}
+
+func (l *rateLimitedLoader[T]) wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	wait := l.notAfter.Sub(now)
+	if wait < 0 {
+		wait = 0
+	}
+	l.notAfter = now.Add(wait + l.interval)
+	l.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
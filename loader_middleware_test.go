@@ -0,0 +1,133 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var errFlaky = errors.New("flaky error")
+
+type flakyLoader struct {
+	calls    int
+	failures int
+}
+
+func (l *flakyLoader) Load(_ context.Context, _ string, _ int) ([]int, string, error) {
+	l.calls++
+	if l.calls <= l.failures {
+		return nil, "", errFlaky
+	}
+	return []int{1, 2, 3}, "next", nil
+}
+
+func isFlaky(err error) bool {
+	return errors.Is(err, errFlaky)
+}
+
+func TestRetryLoader_SucceedsAfterFailures(t *testing.T) {
+	inner := &flakyLoader{failures: 2}
+	loader := RetryLoader[int](inner, RetryPolicy{MaxAttempts: 3, IsRetryable: isFlaky})
+
+	page, nextPageKey, err := loader.Load(context.Background(), "", 10)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3}, page)
+	require.Equal(t, "next", nextPageKey)
+	require.Equal(t, 3, inner.calls)
+}
+
+func TestRetryLoader_ExhaustsAttempts(t *testing.T) {
+	inner := &flakyLoader{failures: 5}
+	loader := RetryLoader[int](inner, RetryPolicy{MaxAttempts: 2, IsRetryable: isFlaky})
+
+	_, _, err := loader.Load(context.Background(), "", 10)
+	require.ErrorIs(t, err, errFlaky)
+	require.Equal(t, 2, inner.calls)
+}
+
+func TestRetryLoader_NonRetryableStopsImmediately(t *testing.T) {
+	inner := &flakyLoader{failures: 5}
+	loader := RetryLoader[int](inner, RetryPolicy{MaxAttempts: 3, IsRetryable: func(error) bool { return false }})
+
+	_, _, err := loader.Load(context.Background(), "", 10)
+	require.ErrorIs(t, err, errFlaky)
+	require.Equal(t, 1, inner.calls)
+}
+
+func TestBackoffLoader_DelaysBetweenAttempts(t *testing.T) {
+	inner := &flakyLoader{failures: 1}
+	loader := BackoffLoader[int](
+		RetryLoader[int](inner, RetryPolicy{MaxAttempts: 2, IsRetryable: isFlaky}),
+		ExponentialBackoff{Base: 10 * time.Millisecond, Max: time.Second},
+	)
+
+	start := time.Now()
+	_, _, err := loader.Load(context.Background(), "", 10)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(start), 5*time.Millisecond)
+}
+
+func TestBackoffLoader_PanicsWithoutRetryLoader(t *testing.T) {
+	inner := &flakyLoader{}
+
+	require.Panics(t, func() {
+		BackoffLoader[int](inner, ExponentialBackoff{Base: time.Millisecond, Max: time.Second})
+	})
+
+	// Composing in the "equally natural" order RateLimitLoader(RetryLoader(...))
+	// must not silently drop the backoff strategy either.
+	require.Panics(t, func() {
+		BackoffLoader[int](
+			RateLimitLoader[int](RetryLoader[int](inner, RetryPolicy{MaxAttempts: 2}), 1000),
+			ExponentialBackoff{Base: time.Millisecond, Max: time.Second},
+		)
+	})
+}
+
+func TestExponentialBackoff_Delay_NeverPanicsOrGoesNegative(t *testing.T) {
+	backoff := ExponentialBackoff{Base: 100 * time.Millisecond, Max: 30 * time.Second}
+
+	for attempt := 0; attempt <= 1000; attempt++ {
+		require.NotPanics(t, func() {
+			delay := backoff.Delay(attempt)
+			require.GreaterOrEqual(t, delay, time.Duration(0))
+			require.LessOrEqual(t, delay, backoff.Max)
+		})
+	}
+}
+
+func TestDistinctErrorLogger_SuppressesRepeats(t *testing.T) {
+	var logged []string
+	logger := NewDistinctErrorLogger(loggerFunc(func(pageKey string, err error) {
+		logged = append(logged, pageKey+":"+err.Error())
+	}))
+
+	logger.LogError("key", errFlaky)
+	logger.LogError("key", errFlaky)
+	logger.LogError("key", errors.New("other error"))
+
+	require.Equal(t, []string{"key:flaky error", "key:other error"}, logged)
+}
+
+type loggerFunc func(pageKey string, err error)
+
+func (f loggerFunc) LogError(pageKey string, err error) { f(pageKey, err) }
+
+func TestRateLimitLoader_Disabled(t *testing.T) {
+	inner := &flakyLoader{}
+	loader := RateLimitLoader[int](inner, 0)
+	require.Same(t, Loader[int](inner), loader)
+}
+
+func TestRateLimitLoader_Delegates(t *testing.T) {
+	inner := &flakyLoader{}
+	loader := RateLimitLoader[int](inner, 1000)
+
+	page, _, err := loader.Load(context.Background(), "", 10)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3}, page)
+	require.Equal(t, 1, inner.calls)
+}
@@ -33,3 +33,31 @@ func WithNextPageLoader[T any](loader Loader[T]) Option[T] {
 		return nil
 	}
 }
+
+// WithCheckpoint registers fn to be invoked with the result of Pager.State
+// after every successful call to Next, so callers can persist pagination
+// progress (e.g. transactionally alongside whatever they do with each page)
+// and resume it later via RestoreState.
+func WithCheckpoint[T any](fn func(state []byte) error) Option[T] {
+	return func(p *Pager[T]) error {
+		if fn == nil {
+			return fmt.Errorf("checkpoint function is required")
+		}
+		p.checkpoint = fn
+		return nil
+	}
+}
+
+// WithPrefetch enables background prefetching: once Next is first called, a
+// background goroutine eagerly loads up to n pages ahead using the Pager's
+// Loader, so later calls to Next pop from an already-loaded buffer instead of
+// blocking on the Loader. Call Close to stop the background goroutine.
+func WithPrefetch[T any](n int) Option[T] {
+	return func(p *Pager[T]) error {
+		if n <= 0 {
+			return fmt.Errorf("prefetch size must be positive")
+		}
+		p.prefetchSize = n
+		return nil
+	}
+}
@@ -18,6 +18,9 @@ type (
 	}
 
 	Pager[T any] struct {
+		// mu guards all mutable fields below, so a Pager can be shared between
+		// the caller and its own prefetch goroutine.
+		mu sync.Mutex
 		// elements count per page.
 		pageSize int
 		// next page key, that will be loaded in next call of Next.
@@ -32,6 +35,27 @@ type (
 		isFirstPageLoaded bool
 		// this field is used to ensure that isFirstPageLoaded is set only once.
 		isFirstPageLoadedOnce sync.Once
+		// number of pages to prefetch in background, see WithPrefetch. Zero disables prefetching.
+		prefetchSize int
+		// channel of results produced by the prefetch goroutine, consumed by Next.
+		prefetchCh chan prefetchResult[T]
+		// cancels the prefetch goroutine, set once it is started.
+		prefetchCancel context.CancelFunc
+		// closed by the prefetch goroutine right before it returns.
+		prefetchDone chan struct{}
+		// set once the prefetch goroutine reports a Loader error; once set,
+		// it is returned by every subsequent Next instead of relying on the
+		// (by then closed, and therefore ambiguous) prefetchCh.
+		prefetchErr error
+		// invoked with the result of State after every successful Next, see WithCheckpoint.
+		checkpoint func(state []byte) error
+	}
+
+	// prefetchResult is one loaded page produced ahead of time by the prefetch goroutine.
+	prefetchResult[T any] struct {
+		page        []T
+		nextPageKey string
+		err         error
 	}
 )
 
@@ -57,20 +81,173 @@ func New[T any](opts ...Option[T]) (*Pager[T], error) {
 }
 
 // Next returns the next page of elements.
+//
+// If the Pager was created with WithPrefetch, Next returns pages from the
+// background look-ahead buffer instead of calling the Loader inline. If the
+// Pager was created with WithCheckpoint, the checkpoint function is invoked
+// with the Pager's serialized State after every successful Next.
 func (p *Pager[T]) Next(ctx context.Context) ([]T, error) {
-	if p.IsAllLoaded() {
+	p.mu.Lock()
+	if p.isAllLoadedLocked() {
+		p.mu.Unlock()
 		return nil, nil
 	}
+	p.mu.Unlock()
 
-	page, nextPageKey, err := p.nextPageLoader.Load(ctx, p.nextPageKey, p.pageSize)
+	var page []T
+	var err error
+	if p.prefetchSize > 0 {
+		page, err = p.nextFromPrefetch(ctx)
+	} else {
+		page, err = p.nextInline(ctx)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("page %s: %w", p.nextPageKey, err)
+		return nil, err
+	}
+
+	p.mu.Lock()
+	checkpoint := p.checkpoint
+	p.mu.Unlock()
+	if checkpoint == nil {
+		return page, nil
 	}
+
+	state, err := p.State()
+	if err != nil {
+		return page, fmt.Errorf("checkpoint: %w", err)
+	}
+	if err := checkpoint(state); err != nil {
+		return page, fmt.Errorf("checkpoint: %w", err)
+	}
+	return page, nil
+}
+
+// nextInline loads the next page directly from the Loader. The lock is not
+// held across the (potentially slow) Load call, only around reading and
+// updating Pager's own state, so it doesn't block Close or other callers
+// inspecting the Pager while a page is in flight.
+func (p *Pager[T]) nextInline(ctx context.Context) ([]T, error) {
+	p.mu.Lock()
+	pageKey, pageSize := p.nextPageKey, p.pageSize
+	p.mu.Unlock()
+
+	page, nextPageKey, err := p.nextPageLoader.Load(ctx, pageKey, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("page %s: %w", pageKey, err)
+	}
+
+	p.mu.Lock()
 	p.nextPageKey = nextPageKey
 	p.pageLoadedAtLeastOnceTime()
+	p.mu.Unlock()
 	return page, nil
 }
 
+// nextFromPrefetch starts the prefetch goroutine on first use and pops the
+// next result from it, preserving strict page ordering. Once the goroutine
+// reports a Loader error, that error is remembered and returned by every
+// subsequent call, instead of falling through to the channel-closed branch,
+// which would otherwise be indistinguishable from pagination finishing
+// successfully. The lock is released while waiting on the channel, so a
+// concurrent Close isn't blocked behind an in-flight Next.
+func (p *Pager[T]) nextFromPrefetch(ctx context.Context) ([]T, error) {
+	p.mu.Lock()
+	if p.prefetchErr != nil {
+		err := p.prefetchErr
+		p.mu.Unlock()
+		return nil, err
+	}
+	if p.prefetchCh == nil {
+		p.startPrefetchLocked(ctx)
+	}
+	ch := p.prefetchCh
+	p.mu.Unlock()
+
+	select {
+	case res, ok := <-ch:
+		if !ok {
+			// The prefetch goroutine closed the channel without reporting an
+			// error: either it drained the Loader completely, or it was
+			// aborted via Close/ctx cancellation. Either way there is no
+			// error to surface, so this behaves like pagination being done.
+			return nil, nil
+		}
+		if res.err != nil {
+			p.mu.Lock()
+			p.prefetchErr = res.err
+			p.mu.Unlock()
+			return nil, res.err
+		}
+
+		p.mu.Lock()
+		p.nextPageKey = res.nextPageKey
+		p.pageLoadedAtLeastOnceTime()
+		p.mu.Unlock()
+		return res.page, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// startPrefetchLocked spawns the background goroutine that eagerly loads up
+// to prefetchSize pages ahead of the caller. Must be called with p.mu held.
+func (p *Pager[T]) startPrefetchLocked(ctx context.Context) {
+	prefetchCtx, cancel := context.WithCancel(ctx)
+	p.prefetchCancel = cancel
+	p.prefetchCh = make(chan prefetchResult[T], p.prefetchSize)
+	p.prefetchDone = make(chan struct{})
+
+	key := p.nextPageKey
+	firstLoaded := p.isFirstPageLoaded
+
+	go func() {
+		defer close(p.prefetchDone)
+		defer close(p.prefetchCh)
+
+		for firstLoaded == false || key != "" {
+			page, nextKey, err := p.nextPageLoader.Load(prefetchCtx, key, p.pageSize)
+			result := prefetchResult[T]{page: page, nextPageKey: nextKey, err: err}
+			if err != nil {
+				result.err = fmt.Errorf("page %s: %w", key, err)
+			}
+
+			select {
+			case p.prefetchCh <- result:
+			case <-prefetchCtx.Done():
+				return
+			}
+
+			if err != nil {
+				return
+			}
+			firstLoaded = true
+			key = nextKey
+		}
+	}()
+}
+
+// Close shuts down the background prefetch goroutine, if any, and waits for
+// it to exit or for ctx to be done. Close is a no-op for a Pager created
+// without WithPrefetch.
+func (p *Pager[T]) Close(ctx context.Context) error {
+	p.mu.Lock()
+	cancel := p.prefetchCancel
+	done := p.prefetchDone
+	p.mu.Unlock()
+
+	if cancel == nil {
+		return nil
+	}
+	cancel()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // All returns all elements from all pages.
 func (p *Pager[T]) All(ctx context.Context) ([]T, error) {
 	allPages := make([]T, 0, p.pageSize)
@@ -85,6 +262,13 @@ func (p *Pager[T]) All(ctx context.Context) ([]T, error) {
 }
 
 func (p *Pager[T]) IsAllLoaded() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.isAllLoadedLocked()
+}
+
+// isAllLoadedLocked is IsAllLoaded without locking. Must be called with p.mu held.
+func (p *Pager[T]) isAllLoadedLocked() bool {
 	return p.nextPageKey == "" && p.isFirstPageLoaded
 }
 
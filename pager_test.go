@@ -6,6 +6,7 @@ import (
 	"go.uber.org/mock/gomock"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -302,6 +303,116 @@ func TestPager_All(t *testing.T) {
 	}
 }
 
+func TestPager_Next_WithPrefetch(t *testing.T) {
+	loader := &fakeLoader{
+		pageByKey: map[string][]int{
+			"":                {1, 2, 3},
+			"second-page-key": {4, 5, 6},
+			"third-page-key":  {7, 8},
+		},
+		nextPageKeyByPageKey: map[string]string{
+			"":                "second-page-key",
+			"second-page-key": "third-page-key",
+			"third-page-key":  "",
+		},
+		errOnPageKey: "no-error",
+	}
+
+	pager, err := New[int](
+		WithNextPageLoader[int](loader),
+		WithPageSize[int](3),
+		WithPrefetch[int](2),
+	)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, pager.Close(context.Background())) }()
+
+	got, err := pager.All(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, got)
+}
+
+func TestPager_Next_WithPrefetch_ErrorPersistsOnSubsequentCalls(t *testing.T) {
+	loader := &fakeLoader{
+		pageByKey:            map[string][]int{"": {1, 2, 3}},
+		nextPageKeyByPageKey: map[string]string{"": "second-page-key"},
+		errOnPageKey:         "second-page-key",
+	}
+
+	pager, err := New[int](
+		WithNextPageLoader[int](loader),
+		WithPageSize[int](3),
+		WithPrefetch[int](1),
+	)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, pager.Close(context.Background())) }()
+
+	got, err := pager.Next(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3}, got)
+
+	got, err = pager.Next(context.Background())
+	require.Nil(t, got)
+	require.EqualError(t, err, "page second-page-key: test error")
+
+	// A third call must keep surfacing the same error instead of silently
+	// returning (nil, nil), which would be indistinguishable from having
+	// finished pagination and would spin a `for !IsAllLoaded()` loop forever.
+	got, err = pager.Next(context.Background())
+	require.Nil(t, got)
+	require.EqualError(t, err, "page second-page-key: test error")
+	require.False(t, pager.IsAllLoaded())
+}
+
+func TestPager_Close_DoesNotBlockOnInFlightPrefetchNext(t *testing.T) {
+	blockLoad := make(chan struct{})
+	loader := &blockingLoader{unblock: blockLoad}
+
+	pager, err := New[int](
+		WithNextPageLoader[int](loader),
+		WithPrefetch[int](1),
+	)
+	require.NoError(t, err)
+
+	nextDone := make(chan struct{})
+	go func() {
+		defer close(nextDone)
+		_, _ = pager.Next(context.Background())
+	}()
+
+	// Give the prefetch goroutine a moment to start its (blocked) Load call.
+	time.Sleep(10 * time.Millisecond)
+
+	closeCtx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	require.NoError(t, pager.Close(closeCtx))
+
+	close(blockLoad)
+	<-nextDone
+}
+
+type blockingLoader struct {
+	unblock <-chan struct{}
+}
+
+func (l *blockingLoader) Load(ctx context.Context, _ string, _ int) ([]int, string, error) {
+	select {
+	case <-l.unblock:
+		return []int{1}, "", nil
+	case <-ctx.Done():
+		return nil, "", ctx.Err()
+	}
+}
+
+func TestPager_Close_NoPrefetch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pager, err := New[int](WithNextPageLoader[int](NewMockLoader[int](ctrl)))
+	require.NoError(t, err)
+
+	require.NoError(t, pager.Close(context.Background()))
+}
+
 type fakeLoader struct {
 	pageByKey            map[string][]int
 	nextPageKeyByPageKey map[string]string
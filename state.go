@@ -0,0 +1,76 @@
+package page
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// stateSchemaVersion is bumped whenever the shape of pagerState changes, so
+// RestoreState can reject state it no longer knows how to interpret.
+const stateSchemaVersion = 1
+
+// pagerState is the JSON-serializable snapshot produced by Pager.State and
+// consumed by RestoreState.
+type pagerState struct {
+	Version           int    `json:"version"`
+	NextPageKey       string `json:"next_page_key"`
+	PageSize          int    `json:"page_size"`
+	IsFirstPageLoaded bool   `json:"is_first_page_loaded"`
+}
+
+// State returns a JSON snapshot of the Pager's cursor, suitable for
+// checkpointing to disk or a database and later resuming via RestoreState in
+// a new process. The Loader itself is not part of the snapshot; it must be
+// re-supplied to RestoreState via options.
+func (p *Pager[T]) State() ([]byte, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.stateLocked()
+}
+
+// stateLocked is State without locking. Must be called with p.mu held.
+func (p *Pager[T]) stateLocked() ([]byte, error) {
+	state := pagerState{
+		Version:           stateSchemaVersion,
+		NextPageKey:       p.nextPageKey,
+		PageSize:          p.pageSize,
+		IsFirstPageLoaded: p.isFirstPageLoaded,
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("marshal pager state: %w", err)
+	}
+	return data, nil
+}
+
+// RestoreState rebuilds a Pager from a snapshot previously produced by
+// State. opts must at least supply WithNextPageLoader; any WithPageSize or
+// WithNextPageKey passed in opts is overridden by the restored state.
+func RestoreState[T any](data []byte, opts ...Option[T]) (*Pager[T], error) {
+	var state pagerState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("unmarshal pager state: %w", err)
+	}
+	if state.Version != stateSchemaVersion {
+		return nil, fmt.Errorf("unsupported pager state schema version %d", state.Version)
+	}
+
+	restoreOpts := make([]Option[T], 0, len(opts)+2)
+	restoreOpts = append(restoreOpts, opts...)
+	restoreOpts = append(restoreOpts, WithPageSize[T](state.PageSize))
+
+	pager, err := New[T](restoreOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set directly rather than via WithNextPageKey, which rejects the empty
+	// key of a state whose pagination has finished.
+	pager.nextPageKey = state.NextPageKey
+
+	if state.IsFirstPageLoaded {
+		pager.pageLoadedAtLeastOnceTime()
+	}
+	return pager, nil
+}
@@ -0,0 +1,91 @@
+package page
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPager_State_RestoreState(t *testing.T) {
+	loader := &fakeLoader{
+		pageByKey: map[string][]int{
+			"":                {1, 2, 3},
+			"second-page-key": {4, 5, 6},
+		},
+		nextPageKeyByPageKey: map[string]string{
+			"":                "second-page-key",
+			"second-page-key": "",
+		},
+		errOnPageKey: "no-error",
+	}
+
+	pager, err := New[int](WithNextPageLoader[int](loader), WithPageSize[int](3))
+	require.NoError(t, err)
+
+	_, err = pager.Next(context.Background())
+	require.NoError(t, err)
+
+	state, err := pager.State()
+	require.NoError(t, err)
+
+	var decoded pagerState
+	require.NoError(t, json.Unmarshal(state, &decoded))
+	require.Equal(t, pagerState{Version: 1, NextPageKey: "second-page-key", PageSize: 3, IsFirstPageLoaded: true}, decoded)
+
+	restored, err := RestoreState[int](state, WithNextPageLoader[int](loader))
+	require.NoError(t, err)
+	require.Equal(t, "second-page-key", restored.nextPageKey)
+	require.Equal(t, 3, restored.pageSize)
+	require.True(t, restored.isFirstPageLoaded)
+
+	got, err := restored.All(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []int{4, 5, 6}, got)
+}
+
+func TestRestoreState_UnsupportedVersion(t *testing.T) {
+	data, err := json.Marshal(pagerState{Version: 99})
+	require.NoError(t, err)
+
+	_, err = RestoreState[int](data, WithNextPageLoader[int](&fakeLoader{}))
+	require.EqualError(t, err, "unsupported pager state schema version 99")
+}
+
+func TestWithCheckpoint(t *testing.T) {
+	loader := &fakeLoader{
+		pageByKey: map[string][]int{
+			"":                {1, 2, 3},
+			"second-page-key": {4, 5, 6},
+		},
+		nextPageKeyByPageKey: map[string]string{
+			"":                "second-page-key",
+			"second-page-key": "",
+		},
+		errOnPageKey: "no-error",
+	}
+
+	var checkpoints []pagerState
+	pager, err := New[int](
+		WithNextPageLoader[int](loader),
+		WithPageSize[int](3),
+		WithCheckpoint[int](func(state []byte) error {
+			var decoded pagerState
+			if err := json.Unmarshal(state, &decoded); err != nil {
+				return err
+			}
+			checkpoints = append(checkpoints, decoded)
+			return nil
+		}),
+	)
+	require.NoError(t, err)
+
+	_, err = pager.All(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, []pagerState{
+		{Version: 1, NextPageKey: "second-page-key", PageSize: 3, IsFirstPageLoaded: true},
+		{Version: 1, NextPageKey: "", PageSize: 3, IsFirstPageLoaded: true},
+	}, checkpoints)
+}
@@ -0,0 +1,173 @@
+package page
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strconv"
+	"sync"
+)
+
+type (
+	// Group is one group of elements sharing the same key, as produced by a
+	// Loader wrapped with WithGroupBy.
+	Group[K comparable, T any] struct {
+		Key   K
+		Items []T
+	}
+
+	filteringLoader[T any] struct {
+		next Loader[T]
+		pred func(T) bool
+	}
+
+	mapLoader[T, U any] struct {
+		source *Pager[T]
+		fn     func(T) U
+	}
+
+	groupingLoader[T any, K cmp.Ordered] struct {
+		next  Loader[T]
+		keyFn func(T) K
+		order string
+
+		mu      sync.Mutex
+		drained bool
+		groups  []Group[K, T]
+	}
+)
+
+// WithFilter wraps loader so that only elements matching pred are included
+// in each loaded page. Pages that end up empty after filtering are still
+// returned (with whatever next page key the underlying Loader reported), the
+// same way a Loader returning a naturally empty page behaves.
+func WithFilter[T any](loader Loader[T], pred func(T) bool) Loader[T] {
+	return &filteringLoader[T]{next: loader, pred: pred}
+}
+
+// Load implements Loader.
+func (l *filteringLoader[T]) Load(ctx context.Context, pageKey string, pageSize int) ([]T, string, error) {
+	page, nextPageKey, err := l.next.Load(ctx, pageKey, pageSize)
+	if err != nil {
+		return nil, "", err
+	}
+
+	filtered := make([]T, 0, len(page))
+	for _, el := range page {
+		if l.pred(el) {
+			filtered = append(filtered, el)
+		}
+	}
+	return filtered, nextPageKey, nil
+}
+
+// Map returns a new Pager[U] that yields source's elements transformed by
+// fn, one source page at a time. Calling Next/All on the returned Pager
+// drives source via source.Next, so the two pagers must not be driven
+// independently of one another.
+func Map[T, U any](source *Pager[T], fn func(T) U) (*Pager[U], error) {
+	return New[U](WithNextPageLoader[U](&mapLoader[T, U]{source: source, fn: fn}))
+}
+
+// Load implements Loader. pageKey and pageSize are ignored: pagination state
+// lives in source.
+func (l *mapLoader[T, U]) Load(ctx context.Context, _ string, _ int) ([]U, string, error) {
+	page, err := l.source.Next(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	mapped := make([]U, len(page))
+	for i, el := range page {
+		mapped[i] = l.fn(el)
+	}
+
+	nextPageKey := "continue"
+	if l.source.IsAllLoaded() {
+		nextPageKey = ""
+	}
+	return mapped, nextPageKey, nil
+}
+
+// WithGroupBy wraps loader so that it yields Group[K, T] chunks instead of T
+// elements: all of loader's elements are grouped by keyFn and the groups are
+// ordered by key ("asc" or "desc", default "asc"). Since a group can only be
+// known to be complete once every element has been seen, the first Load call
+// drains loader fully into an internal buffer; subsequent calls serve groups
+// from that buffer, re-chunked into pages of the caller's chosen pageSize
+// regardless of loader's native page size.
+func WithGroupBy[T any, K cmp.Ordered](loader Loader[T], keyFn func(T) K, order string) Loader[Group[K, T]] {
+	return &groupingLoader[T, K]{next: loader, keyFn: keyFn, order: order}
+}
+
+// Load implements Loader.
+func (l *groupingLoader[T, K]) Load(ctx context.Context, pageKey string, pageSize int) ([]Group[K, T], string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.drained {
+		if err := l.drainLocked(ctx); err != nil {
+			return nil, "", err
+		}
+	}
+
+	offset := 0
+	if pageKey != "" {
+		parsed, err := strconv.Atoi(pageKey)
+		if err != nil {
+			return nil, "", err
+		}
+		offset = parsed
+	}
+	if offset >= len(l.groups) {
+		return nil, "", nil
+	}
+
+	end := min(offset+pageSize, len(l.groups))
+	chunk := l.groups[offset:end]
+
+	nextPageKey := ""
+	if end < len(l.groups) {
+		nextPageKey = strconv.Itoa(end)
+	}
+	return chunk, nextPageKey, nil
+}
+
+func (l *groupingLoader[T, K]) drainLocked(ctx context.Context) error {
+	byKey := make(map[K][]T)
+	var keys []K
+
+	pageKey, firstLoaded := "", false
+	for !firstLoaded || pageKey != "" {
+		page, nextPageKey, err := l.next.Load(ctx, pageKey, defaultPageSize)
+		if err != nil {
+			return err
+		}
+
+		for _, el := range page {
+			key := l.keyFn(el)
+			if _, ok := byKey[key]; !ok {
+				keys = append(keys, key)
+			}
+			byKey[key] = append(byKey[key], el)
+		}
+
+		pageKey = nextPageKey
+		firstLoaded = true
+	}
+
+	slices.SortFunc(keys, func(a, b K) int {
+		c := cmp.Compare(a, b)
+		if l.order == "desc" {
+			return -c
+		}
+		return c
+	})
+
+	l.groups = make([]Group[K, T], len(keys))
+	for i, key := range keys {
+		l.groups[i] = Group[K, T]{Key: key, Items: byKey[key]}
+	}
+	l.drained = true
+	return nil
+}
@@ -0,0 +1,92 @@
+package page
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithFilter(t *testing.T) {
+	loader := &fakeLoader{
+		pageByKey:            map[string][]int{"": {1, 2, 3, 4, 5, 6}},
+		nextPageKeyByPageKey: map[string]string{"": ""},
+		errOnPageKey:         "no-error",
+	}
+
+	pager, err := New[int](WithNextPageLoader[int](WithFilter[int](loader, func(v int) bool { return v%2 == 0 })))
+	require.NoError(t, err)
+
+	got, err := pager.All(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []int{2, 4, 6}, got)
+}
+
+func TestMap(t *testing.T) {
+	loader := &fakeLoader{
+		pageByKey: map[string][]int{
+			"":                {1, 2, 3},
+			"second-page-key": {4, 5, 6},
+		},
+		nextPageKeyByPageKey: map[string]string{
+			"":                "second-page-key",
+			"second-page-key": "",
+		},
+		errOnPageKey: "no-error",
+	}
+
+	source, err := New[int](WithNextPageLoader[int](loader), WithPageSize[int](3))
+	require.NoError(t, err)
+
+	mapped, err := Map[int, string](source, strconv.Itoa)
+	require.NoError(t, err)
+
+	got, err := mapped.All(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "2", "3", "4", "5", "6"}, got)
+}
+
+func TestWithGroupBy(t *testing.T) {
+	loader := &fakeLoader{
+		pageByKey: map[string][]int{
+			"":                {1, 2, 3},
+			"second-page-key": {4, 5, 6},
+		},
+		nextPageKeyByPageKey: map[string]string{
+			"":                "second-page-key",
+			"second-page-key": "",
+		},
+		errOnPageKey: "no-error",
+	}
+
+	grouped := WithGroupBy[int, int](loader, func(v int) int { return v % 2 }, "asc")
+	pager, err := New[Group[int, int]](WithNextPageLoader[Group[int, int]](grouped), WithPageSize[Group[int, int]](1))
+	require.NoError(t, err)
+
+	got, err := pager.All(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []Group[int, int]{
+		{Key: 0, Items: []int{2, 4, 6}},
+		{Key: 1, Items: []int{1, 3, 5}},
+	}, got)
+}
+
+func TestWithGroupBy_DescOrder(t *testing.T) {
+	loader := &fakeLoader{
+		pageByKey:            map[string][]int{"": {1, 2, 3}},
+		nextPageKeyByPageKey: map[string]string{"": ""},
+		errOnPageKey:         "no-error",
+	}
+
+	grouped := WithGroupBy[int, int](loader, func(v int) int { return v % 2 }, "desc")
+	pager, err := New[Group[int, int]](WithNextPageLoader[Group[int, int]](grouped))
+	require.NoError(t, err)
+
+	got, err := pager.All(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []Group[int, int]{
+		{Key: 1, Items: []int{1, 3}},
+		{Key: 0, Items: []int{2}},
+	}, got)
+}